@@ -0,0 +1,11 @@
+package params
+
+// Simulation parameter constants
+const (
+	DefaultWeightMsgTransferNFT     int = 100
+	DefaultWeightMsgEditNFTMetadata int = 75
+	DefaultWeightMsgMintNFT         int = 100
+	DefaultWeightMsgBurnNFT         int = 50
+
+	DefaultWeightNFTProposal int = 5
+)
@@ -0,0 +1,39 @@
+package simapp
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	nft "github.com/cosmos/cosmos-sdk/x/nft"
+	nftclient "github.com/cosmos/cosmos-sdk/x/nft/client"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/internal/keeper"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+// nftProposalHandlers are the nft module's governance proposal CLI/REST
+// handlers. They belong in NewSimApp's gov.NewAppModuleBasic(...) call
+// alongside the other modules' proposal handlers, e.g.:
+//
+//	gov.NewAppModuleBasic(append(
+//		[]govclient.ProposalHandler{
+//			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler,
+//		},
+//		nftProposalHandlers...,
+//	)...)
+var nftProposalHandlers = []govclient.ProposalHandler{
+	nftclient.UpdateDenomOwnerProposalHandler,
+	nftclient.ForceBurnNFTProposalHandler,
+}
+
+// RegisterNFTProposalRoute adds the nft module's governance proposal route
+// to router, so UpdateDenomOwnerProposal/ForceBurnNFTProposal content
+// submitted through gov actually reaches keeper.ReassignDenom/ForceBurn.
+// NewSimApp's gov router construction chains this in alongside the other
+// modules' routes:
+//
+//	govRouter := govtypes.NewRouter().
+//		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+//		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper))
+//	govRouter = RegisterNFTProposalRoute(govRouter, app.nftKeeper)
+func RegisterNFTProposalRoute(router govtypes.Router, k nftkeeper.Keeper) govtypes.Router {
+	return router.AddRoute(nfttypes.RouterKey, nft.NewNFTProposalHandler(k))
+}
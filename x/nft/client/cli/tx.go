@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"bufio"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+const (
+	flagTitle       = "title"
+	flagDescription = "description"
+)
+
+// GetCmdSubmitUpdateDenomOwnerProposal implements the command to submit an
+// update-denom-owner governance proposal, reassigning ownership of every
+// NFT under a denom to a new address.
+func GetCmdSubmitUpdateDenomOwnerProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-denom-owner [denom] [new-owner] [deposit]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to reassign ownership of an NFT denom",
+		Long: `Submit a governance proposal that reassigns ownership of every NFT under
+a denom to a new owner, for use when a denom owner key is lost or compromised.
+
+Example:
+$ gaiacli tx gov submit-proposal update-denom-owner crypto-kitties cosmos1... 1000stake --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := utils.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			denom := args[0]
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(flagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(flagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateDenomOwnerProposal(title, description, denom, newOwner)
+
+			from := cliCtx.GetFromAddress()
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "title of the proposal")
+	cmd.Flags().String(flagDescription, "", "description of the proposal")
+
+	return cmd
+}
+
+// GetCmdSubmitForceBurnNFTProposal implements the command to submit a
+// force-burn-nft governance proposal, burning an NFT regardless of its
+// current owner.
+func GetCmdSubmitForceBurnNFTProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-burn-nft [denom] [id] [deposit]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to force-burn an NFT",
+		Long: `Submit a governance proposal that burns an NFT regardless of who currently
+owns it, for use when an NFT must be removed from circulation.
+
+Example:
+$ gaiacli tx gov submit-proposal force-burn-nft crypto-kitties kitty1 1000stake --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := utils.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			denom, id := args[0], args[1]
+
+			deposit, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(flagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(flagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewForceBurnNFTProposal(title, description, denom, id)
+
+			from := cliCtx.GetFromAddress()
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "title of the proposal")
+	cmd.Flags().String(flagDescription, "", "description of the proposal")
+
+	return cmd
+}
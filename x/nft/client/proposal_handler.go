@@ -0,0 +1,21 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/nft/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/nft/client/rest"
+)
+
+// UpdateDenomOwnerProposalHandler pairs the CLI and REST handlers for the
+// update-denom-owner proposal, for inclusion in the app's
+// gov.NewAppModuleBasic proposal handler list.
+var UpdateDenomOwnerProposalHandler = govclient.NewProposalHandler(
+	cli.GetCmdSubmitUpdateDenomOwnerProposal, rest.UpdateDenomOwnerProposalRESTHandler,
+)
+
+// ForceBurnNFTProposalHandler pairs the CLI and REST handlers for the
+// force-burn-nft proposal, for inclusion in the app's
+// gov.NewAppModuleBasic proposal handler list.
+var ForceBurnNFTProposalHandler = govclient.NewProposalHandler(
+	cli.GetCmdSubmitForceBurnNFTProposal, rest.ForceBurnNFTProposalRESTHandler,
+)
@@ -0,0 +1,103 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+// UpdateDenomOwnerProposalReq defines a request to submit an
+// update-denom-owner proposal via REST.
+type UpdateDenomOwnerProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title    string         `json:"title" yaml:"title"`
+	Descr    string         `json:"description" yaml:"description"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+}
+
+// ForceBurnNFTProposalReq defines a request to submit a force-burn-nft
+// proposal via REST.
+type ForceBurnNFTProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title    string         `json:"title" yaml:"title"`
+	Descr    string         `json:"description" yaml:"description"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	ID       string         `json:"id" yaml:"id"`
+	Deposit  sdk.Coins      `json:"deposit" yaml:"deposit"`
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+}
+
+// UpdateDenomOwnerProposalRESTHandler returns the REST handler that submits
+// an update-denom-owner proposal.
+func UpdateDenomOwnerProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_denom_owner",
+		Handler:  postUpdateDenomOwnerProposalHandlerFn(cliCtx),
+	}
+}
+
+// ForceBurnNFTProposalRESTHandler returns the REST handler that submits a
+// force-burn-nft proposal.
+func ForceBurnNFTProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "force_burn_nft",
+		Handler:  postForceBurnNFTProposalHandlerFn(cliCtx),
+	}
+}
+
+func postUpdateDenomOwnerProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateDenomOwnerProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateDenomOwnerProposal(req.Title, req.Descr, req.Denom, req.NewOwner)
+
+		msg := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postForceBurnNFTProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ForceBurnNFTProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewForceBurnNFTProposal(req.Title, req.Descr, req.Denom, req.ID)
+
+		msg := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
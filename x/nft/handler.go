@@ -0,0 +1,26 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+// NewNFTProposalHandler handles governance proposals that administer NFT
+// collections: reassigning a denom's ownership or force-burning an NFT
+// regardless of its current owner. Register it on the gov router with
+// `gov.NewRouter().AddRoute(types.RouterKey, nft.NewNFTProposalHandler(k))`.
+func NewNFTProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.UpdateDenomOwnerProposal:
+			return k.ReassignDenom(ctx, c.Denom, c.NewOwner)
+		case types.ForceBurnNFTProposal:
+			return k.ForceBurn(ctx, c.Denom, c.ID)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized nft proposal content type: %T", c)
+		}
+	}
+}
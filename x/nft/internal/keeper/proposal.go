@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+// ForceBurn removes the NFT identified by (denom, id) from the store and
+// the current owner's collection, bypassing the owner-only check performed
+// by the BurnNFT msg handler. It is only ever invoked from the governance
+// proposal handler.
+func (k Keeper) ForceBurn(ctx sdk.Context, denom, id string) error {
+	nft, err := k.GetNFT(ctx, denom, id)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DeleteNFT(ctx, denom, nft); err != nil {
+		return sdkerrors.Wrapf(err, "could not force burn NFT %s from collection %s", id, denom)
+	}
+
+	return nil
+}
+
+// ReassignDenom transfers ownership of every NFT under denom to newOwner,
+// bypassing the owner-only TransferNFT msg handler for each individual
+// token. It is only ever invoked from the governance proposal handler.
+func (k Keeper) ReassignDenom(ctx sdk.Context, denom string, newOwner sdk.AccAddress) error {
+	collection, found := k.GetCollection(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownCollection, "collection %s does not exist", denom)
+	}
+
+	for _, nft := range collection.NFTs {
+		if err := k.SwapOwner(ctx, denom, nft.GetID(), newOwner); err != nil {
+			return sdkerrors.Wrapf(err, "could not reassign NFT %s in collection %s", nft.GetID(), denom)
+		}
+	}
+
+	return nil
+}
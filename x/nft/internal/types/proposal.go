@@ -0,0 +1,146 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateDenomOwner defines the type for a UpdateDenomOwnerProposal
+	ProposalTypeUpdateDenomOwner = "UpdateDenomOwner"
+	// ProposalTypeForceBurnNFT defines the type for a ForceBurnNFTProposal
+	ProposalTypeForceBurnNFT = "ForceBurnNFT"
+	// RouterKey is the message route for the nft module, also used as the
+	// gov proposal route for NFT administrative proposals
+	RouterKey = ModuleName
+)
+
+// Assert proposals implement govtypes.Content at compile time
+var (
+	_ govtypes.Content = UpdateDenomOwnerProposal{}
+	_ govtypes.Content = ForceBurnNFTProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateDenomOwner)
+	govtypes.RegisterProposalTypeCodec(UpdateDenomOwnerProposal{}, "cosmos-sdk/UpdateDenomOwnerProposal")
+	govtypes.RegisterProposalType(ProposalTypeForceBurnNFT)
+	govtypes.RegisterProposalTypeCodec(ForceBurnNFTProposal{}, "cosmos-sdk/ForceBurnNFTProposal")
+}
+
+// UpdateDenomOwnerProposal reassigns ownership of every NFT under denom to
+// NewOwner. It is intended as a governance-gated remedy for lost or
+// compromised denom owner keys.
+type UpdateDenomOwnerProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Denom       string         `json:"denom" yaml:"denom"`
+	NewOwner    sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+// NewUpdateDenomOwnerProposal creates a new UpdateDenomOwnerProposal
+func NewUpdateDenomOwnerProposal(title, description, denom string, newOwner sdk.AccAddress) UpdateDenomOwnerProposal {
+	return UpdateDenomOwnerProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		NewOwner:    newOwner,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p UpdateDenomOwnerProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p UpdateDenomOwnerProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p UpdateDenomOwnerProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p UpdateDenomOwnerProposal) ProposalType() string { return ProposalTypeUpdateDenomOwner }
+
+// ValidateBasic validates the proposal
+func (p UpdateDenomOwnerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.Denom) == "" {
+		return fmt.Errorf("denom cannot be blank")
+	}
+	if p.NewOwner.Empty() {
+		return fmt.Errorf("new owner cannot be empty")
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p UpdateDenomOwnerProposal) String() string {
+	return fmt.Sprintf(`Update Denom Owner Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  New Owner:   %s
+`, p.Title, p.Description, p.Denom, p.NewOwner)
+}
+
+// ForceBurnNFTProposal force-burns the NFT identified by (Denom, ID),
+// bypassing the normal owner-only BurnNFT msg. It is intended as a
+// governance-gated remedy for NFTs that must be removed from circulation
+// (e.g. minted in error, or subject to a legal takedown) regardless of who
+// currently holds them.
+type ForceBurnNFTProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Denom       string `json:"denom" yaml:"denom"`
+	ID          string `json:"id" yaml:"id"`
+}
+
+// NewForceBurnNFTProposal creates a new ForceBurnNFTProposal
+func NewForceBurnNFTProposal(title, description, denom, id string) ForceBurnNFTProposal {
+	return ForceBurnNFTProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ID:          id,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p ForceBurnNFTProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p ForceBurnNFTProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p ForceBurnNFTProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p ForceBurnNFTProposal) ProposalType() string { return ProposalTypeForceBurnNFT }
+
+// ValidateBasic validates the proposal
+func (p ForceBurnNFTProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.Denom) == "" {
+		return fmt.Errorf("denom cannot be blank")
+	}
+	if strings.TrimSpace(p.ID) == "" {
+		return fmt.Errorf("id cannot be blank")
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p ForceBurnNFTProposal) String() string {
+	return fmt.Sprintf(`Force Burn NFT Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  ID:          %s
+`, p.Title, p.Description, p.Denom, p.ID)
+}
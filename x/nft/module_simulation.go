@@ -0,0 +1,58 @@
+package nft
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/simulation"
+	simtypes "github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// AppModule wires the nft module's simulation hooks up for `simd simulate`.
+// It implements only the simulation methods below; the rest of the
+// module.AppModule surface (msg handler, querier, InitGenesis, etc.) is not
+// implemented on this type.
+type AppModule struct {
+	cdc           *codec.Codec
+	keeper        keeper.Keeper
+	accountKeeper types.AccountKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(cdc *codec.Codec, k keeper.Keeper, ak types.AccountKeeper) AppModule {
+	return AppModule{
+		cdc:           cdc,
+		keeper:        k,
+		accountKeeper: ak,
+	}
+}
+
+// GenerateGenesisState creates a randomized GenState for the nft module
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RandomizedParams creates randomized nft param changes for the simulator
+func (AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
+	return simulation.RandomizedParams(r)
+}
+
+// ProposalContents returns the weighted governance proposal contents simulated for the nft module
+func (am AppModule) ProposalContents(_ module.SimulationState) []govsim.WeightedProposalContent {
+	return simulation.ProposalContents(am.keeper)
+}
+
+// RegisterStoreDecoder registers a decoder for nft module's types
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns all the nft module operations with their respective weights
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.accountKeeper, am.keeper)
+}
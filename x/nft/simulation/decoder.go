@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding nft type.
+func NewDecodeStore(cdc *codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.CollectionsKeyPrefix):
+			var collectionA, collectionB types.Collection
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &collectionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &collectionB)
+			return fmt.Sprintf("%v\n%v", collectionA, collectionB)
+
+		case bytes.Equal(kvA.Key[:1], types.OwnersKeyPrefix):
+			var idCollectionA, idCollectionB types.IDCollection
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &idCollectionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &idCollectionB)
+			return fmt.Sprintf("%v\n%v", idCollectionA, idCollectionB)
+
+		case bytes.Equal(kvA.Key[:1], types.SupplyKeyPrefix):
+			supplyA := binary.BigEndian.Uint64(kvA.Value)
+			supplyB := binary.BigEndian.Uint64(kvB.Value)
+			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+
+		default:
+			panic(fmt.Sprintf("invalid nft key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
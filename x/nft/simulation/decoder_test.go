@@ -0,0 +1,54 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.New()
+
+	collection := types.NewCollection("crypto-kitties", types.NewNFTs())
+	idCollection := types.NewIDCollection("crypto-kitties", []string{"1", "2"})
+	supply := uint64(10)
+
+	supplyBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(supplyBz, supply)
+
+	kvPairs := kv.Pairs{
+		kv.Pair{Key: types.CollectionsKeyPrefix, Value: cdc.MustMarshalBinaryBare(collection)},
+		kv.Pair{Key: types.OwnersKeyPrefix, Value: cdc.MustMarshalBinaryBare(idCollection)},
+		kv.Pair{Key: types.SupplyKeyPrefix, Value: supplyBz},
+		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Collections", fmt.Sprintf("%v\n%v", collection, collection)},
+		{"Owners", fmt.Sprintf("%v\n%v", idCollection, idCollection)},
+		{"Supply", fmt.Sprintf("%v\n%v", supply, supply)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { simulation.NewDecodeStore(cdc)(kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, simulation.NewDecodeStore(cdc)(kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
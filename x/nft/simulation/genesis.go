@@ -0,0 +1,108 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+const (
+	denomLen    = 10
+	nftIDLen    = 10
+	tokenURILen = 45
+
+	// maxNFTCollections is the maximum number of distinct NFT denoms to
+	// generate genesis state for.
+	maxNFTCollections = 10
+	// maxNFTsPerCollection is the maximum number of NFTs minted within a
+	// single generated collection.
+	maxNFTsPerCollection = 10
+)
+
+// RandomizedGenState generates a random GenesisState for the nft module. It
+// mints a random number of NFTs across a random number of denoms and
+// distributes ownership across the accounts provided by simState, so that
+// the simulator starts from a non-empty NFT state instead of relying
+// entirely on MintNFT operations to populate it.
+func RandomizedGenState(simState *module.SimulationState) {
+	var collections []types.Collection
+	// address -> denom -> IDCollection, since a single account can own NFTs
+	// across more than one of the generated denoms.
+	ownedIDs := make(map[string]map[string]types.IDCollection)
+
+	numCollections := simState.Rand.Intn(maxNFTCollections) + 1
+	for i := 0; i < numCollections; i++ {
+		denom := randomDenom(simState.Rand, i)
+		nfts := randomNFTs(simState.Rand, simState.Accounts, denom, ownedIDs)
+
+		collections = append(collections, types.NewCollection(denom, nfts))
+	}
+
+	owners := make([]types.Owner, 0, len(ownedIDs))
+	for address, idCollectionsByDenom := range ownedIDs {
+		accAddr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			panic(err)
+		}
+
+		idCollections := make(types.IDCollections, 0, len(idCollectionsByDenom))
+		for _, idCollection := range idCollectionsByDenom {
+			idCollections = append(idCollections, idCollection)
+		}
+
+		owners = append(owners, types.Owner{
+			Address:       accAddr,
+			IDCollections: idCollections,
+		})
+	}
+
+	nftGenesis := types.NewGenesisState(owners, types.NewCollections(collections...))
+
+	fmt.Printf("Selected randomly generated %d NFT collections for genesis\n", len(collections))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(nftGenesis)
+}
+
+// randomDenom generates a unique, lowercase denom for use as an NFT
+// collection identifier: random letters followed by the collection index.
+func randomDenom(r *rand.Rand, seed int) string {
+	return strings.ToLower(simulation.RandStringOfLength(r, denomLen)) + fmt.Sprintf("%d", seed)
+}
+
+// randomNFTs mints a random number of NFTs under denom, assigning each one
+// to a randomly selected account and recording the assignment in ownedIDs,
+// keyed by owner address and then denom.
+func randomNFTs(
+	r *rand.Rand, accs []simulation.Account, denom string, ownedIDs map[string]map[string]types.IDCollection,
+) types.NFTs {
+	numNFTs := r.Intn(maxNFTsPerCollection) + 1
+	nfts := types.NewNFTs()
+
+	for i := 0; i < numNFTs; i++ {
+		owner, _ := simulation.RandomAcc(r, accs)
+		id := simulation.RandStringOfLength(r, nftIDLen)
+		tokenURI := simulation.RandStringOfLength(r, tokenURILen)
+
+		nft := types.NewBaseNFT(id, owner.Address, tokenURI)
+		nfts = nfts.Append(nft)
+
+		address := owner.Address.String()
+		if _, ok := ownedIDs[address]; !ok {
+			ownedIDs[address] = make(map[string]types.IDCollection)
+		}
+
+		idCollection, ok := ownedIDs[address][denom]
+		if !ok {
+			idCollection = types.NewIDCollection(denom, []string{id})
+		} else {
+			idCollection = idCollection.AddID(id)
+		}
+		ownedIDs[address][denom] = idCollection
+	}
+
+	return nfts
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
@@ -17,6 +19,69 @@ import (
 
 // DONTCOVER
 
+// Simulation operation weights constants
+const (
+	OpWeightMsgTransferNFT     = "op_weight_msg_transfer_nft"
+	OpWeightMsgEditNFTMetadata = "op_weight_msg_edit_nft_metadata"
+	OpWeightMsgMintNFT         = "op_weight_msg_mint_nft"
+	OpWeightMsgBurnNFT         = "op_weight_msg_burn_nft"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgTransferNFT     int
+		weightMsgEditNFTMetadata int
+		weightMsgMintNFT         int
+		weightMsgBurnNFT         int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransferNFT, &weightMsgTransferNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferNFT = simappparams.DefaultWeightMsgTransferNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgEditNFTMetadata, &weightMsgEditNFTMetadata, nil,
+		func(_ *rand.Rand) {
+			weightMsgEditNFTMetadata = simappparams.DefaultWeightMsgEditNFTMetadata
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintNFT, &weightMsgMintNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintNFT = simappparams.DefaultWeightMsgMintNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnNFT, &weightMsgBurnNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnNFT = simappparams.DefaultWeightMsgBurnNFT
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgTransferNFT,
+			SimulateMsgTransferNFT(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEditNFTMetadata,
+			SimulateMsgEditNFTMetadata(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintNFT,
+			SimulateMsgMintNFT(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurnNFT,
+			SimulateMsgBurnNFT(ak, k),
+		),
+	}
+}
+
 // SimulateMsgTransferNFT simulates the transfer of an NFT
 // nolint: funlen
 func SimulateMsgTransferNFT(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
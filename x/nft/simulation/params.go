@@ -0,0 +1,16 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// RandomizedParams creates randomized nft param changes for the simulator.
+//
+// The nft module does not expose any on-chain parameters, so there is
+// nothing for a ParamChange proposal to mutate; this satisfies the
+// module.AppModuleSimulation interface with an empty set of changes.
+func RandomizedParams(_ *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{}
+}
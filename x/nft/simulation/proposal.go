@@ -0,0 +1,59 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// OpWeightNFTProposal is the key used to look up how often an NFT
+// governance proposal should be generated relative to other proposal types.
+const OpWeightNFTProposal = "op_weight_nft_proposal"
+
+// ProposalContents defines the module weighted proposals content for simulation.
+func ProposalContents(k keeper.Keeper) []govsim.WeightedProposalContent {
+	return []govsim.WeightedProposalContent{
+		{
+			AppParamsKey:       OpWeightNFTProposal,
+			DefaultWeight:      simappparams.DefaultWeightNFTProposal,
+			ContentSimulatorFn: SimulateNFTProposalContent(k),
+		},
+	}
+}
+
+// SimulateNFTProposalContent generates a random administrative NFT proposal,
+// either reassigning a denom's ownership or force-burning a single randomly
+// chosen NFT, so governance-driven recovery paths get simulation coverage
+// alongside the user-submitted NFT msgs.
+func SimulateNFTProposalContent(k keeper.Keeper) govsim.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simulation.Account) govtypes.Content {
+		ownerAddr, denom, nftID := getRandomNFTFromOwner(ctx, k, r)
+		if ownerAddr.Empty() {
+			return nil
+		}
+
+		if r.Intn(2) == 0 {
+			newOwner, _ := simulation.RandomAcc(r, accs)
+
+			return types.NewUpdateDenomOwnerProposal(
+				simulation.RandStringOfLength(r, 10),
+				simulation.RandStringOfLength(r, 100),
+				denom,
+				newOwner.Address,
+			)
+		}
+
+		return types.NewForceBurnNFTProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			denom,
+			nftID,
+		)
+	}
+}